@@ -0,0 +1,170 @@
+package sls
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultBuildGOOS   = "linux"
+	DefaultBuildGOARCH = "arm64"
+
+	// BootstrapEntryName is the fixed name the provided.al2 custom
+	// runtime looks for at the root of the deployment package; it must
+	// match Deploy's Handler regardless of l.BinaryName.
+	BootstrapEntryName = "bootstrap"
+)
+
+// BuildOptions controls how Service.Build cross-compiles each Lambda.
+// The zero value cross-compiles for GOOS=linux GOARCH=arm64.
+type BuildOptions struct {
+	GOOS   string
+	GOARCH string
+}
+
+func (o BuildOptions) withDefaults() BuildOptions {
+	if o.GOOS == "" {
+		o.GOOS = DefaultBuildGOOS
+	}
+
+	if o.GOARCH == "" {
+		o.GOARCH = DefaultBuildGOARCH
+	}
+
+	return o
+}
+
+// BuildResult records where a Lambda's deployable artifact ended up and
+// the content hash Deploy uses to skip no-op updates.
+type BuildResult struct {
+	Feature string
+	ZipPath string
+	Sha256  string
+}
+
+// Build walks LambdasDir(), cross-compiles every registered Lambda, and
+// zips each binary into BuildDir()/l.BinaryZipName. A failure building
+// one feature does not stop the others; every failure is collected and
+// returned together as a BatchedErrors. Successful results are also kept
+// on s so a later Deploy can compare them against what's already live.
+func (s *Service) Build(ctx context.Context, opts ...BuildOptions) (map[string]BuildResult, error) {
+	var o BuildOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	o = o.withDefaults()
+
+	if err := os.MkdirAll(s.BuildDir(), 0o755); err != nil {
+		return nil, errors.Wrapf(err, "os.MkdirAll failed (%s)", s.BuildDir())
+	}
+
+	results := map[string]BuildResult{}
+	var errs []error
+	for name, l := range s.Features {
+		result, err := s.buildFeature(ctx, l, o)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "build failed (%s)", name))
+			continue
+		}
+
+		results[name] = result
+	}
+
+	if s.builds == nil {
+		s.builds = map[string]BuildResult{}
+	}
+
+	for name, result := range results {
+		s.builds[name] = result
+	}
+
+	return results, NewBatchedErrors(errs)
+}
+
+// buildFeature cross-compiles l's code directory into BuildDir() and
+// zips the resulting binary, recording its content hash.
+func (s *Service) buildFeature(ctx context.Context, l Lambda, o BuildOptions) (BuildResult, error) {
+	var result BuildResult
+
+	srcDir := filepath.Join(s.LambdasDir(), l.CodeDir())
+	binPath := filepath.Join(s.BuildDir(), l.BinaryName)
+
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", binPath, srcDir)
+	cmd.Env = append(os.Environ(), "GOOS="+o.GOOS, "GOARCH="+o.GOARCH, "CGO_ENABLED=0")
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return result, errors.Wrapf(err, "go build failed (%s): %s", srcDir, string(out))
+	}
+
+	zipPath := filepath.Join(s.BuildDir(), l.BinaryZipName)
+	if err := zipFile(binPath, zipPath, BootstrapEntryName); err != nil {
+		return result, errors.Wrap(err, "zipFile failed")
+	}
+
+	sum, err := sha256Base64(zipPath)
+	if err != nil {
+		return result, errors.Wrap(err, "sha256Base64 failed")
+	}
+
+	return BuildResult{Feature: l.QualifiedName(), ZipPath: zipPath, Sha256: sum}, nil
+}
+
+// zipFile writes src into dst as a single executable zip entry named
+// entryName, matching the layout AWS Lambda expects for a deployment
+// package. The entry is written with a FileHeader (not w.Create) so its
+// mode carries the executable bit Lambda needs to run it.
+func zipFile(src, dst, entryName string) error {
+	out, err := os.Create(dst)
+	if err != nil {
+		return errors.Wrapf(err, "os.Create failed (%s)", dst)
+	}
+	defer out.Close()
+
+	w := zip.NewWriter(out)
+	defer w.Close()
+
+	hdr := &zip.FileHeader{Name: entryName, Method: zip.Deflate}
+	hdr.SetMode(0o755)
+
+	entry, err := w.CreateHeader(hdr)
+	if err != nil {
+		return errors.Wrap(err, "zip.Writer.CreateHeader failed")
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.Wrapf(err, "os.Open failed (%s)", src)
+	}
+	defer in.Close()
+
+	if _, err := io.Copy(entry, in); err != nil {
+		return errors.Wrap(err, "io.Copy failed")
+	}
+
+	return nil
+}
+
+// sha256Base64 returns the base64-encoded SHA256 of path's contents,
+// matching the format AWS Lambda reports as a function's CodeSha256.
+func sha256Base64(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "os.Open failed (%s)", path)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", errors.Wrap(err, "io.Copy failed")
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,252 @@
+package sls
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+
+	"github.com/rsb/sls/pstore"
+)
+
+// SSMEnvPrefix marks a Lambda.Env value as a reference to an SSM
+// parameter, e.g. "ssm:/prod/myservice/db/host".
+const SSMEnvPrefix = "ssm:"
+
+// DeployOptions controls Service.Deploy.
+type DeployOptions struct {
+	// DryRun prints, for each built feature, its function name, zip path,
+	// code hash and configured env instead of making any AWS call. It does
+	// not determine whether the function would be created, updated or
+	// left unchanged.
+	DryRun bool
+	// LeaveSSMRefs, when true, passes "ssm:/..." env values through to
+	// the function unresolved instead of resolving them to literal
+	// values at deploy time, so the Lambda can resolve them itself at
+	// cold start.
+	LeaveSSMRefs bool
+}
+
+// DeployResult records what Deploy did (or would do, for a dry run) for
+// a single feature.
+type DeployResult struct {
+	Feature string
+	Action  string
+}
+
+const (
+	DeployActionCreated   = "created"
+	DeployActionUpdated   = "updated"
+	DeployActionUnchanged = "unchanged"
+	DeployActionDryRun    = "dry-run"
+)
+
+// Deploy creates or updates every built feature's Lambda function using
+// lambdaiface.LambdaAPI, resolving "ssm:/..." env values through params
+// before comparing build hashes. A no-op update (the deployed function's
+// CodeSha256 already matches the build) is skipped. A failure deploying
+// one feature does not stop the others; every failure is collected and
+// returned together as a BatchedErrors.
+//
+// params may only be nil if no feature's Env uses an "ssm:" reference
+// (or DeployOptions.LeaveSSMRefs is set); otherwise Deploy returns an
+// error for that feature instead of resolving against a nil client.
+func (s *Service) Deploy(ctx context.Context, params *pstore.Client, opts ...DeployOptions) (map[string]DeployResult, error) {
+	var o DeployOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	results := map[string]DeployResult{}
+	var errs []error
+
+	for name, l := range s.Features {
+		result, err := s.deployFeature(ctx, name, l, params, o)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "deploy failed (%s)", name))
+			continue
+		}
+
+		results[name] = result
+	}
+
+	return results, NewBatchedErrors(errs)
+}
+
+func (s *Service) deployFeature(ctx context.Context, name string, l Lambda, params *pstore.Client, o DeployOptions) (DeployResult, error) {
+	var result DeployResult
+
+	build, ok := s.builds[name]
+	if !ok {
+		return result, errors.Errorf("feature (%s) has not been built, call Service.Build first", name)
+	}
+
+	functionName := l.QualifiedName()
+
+	if o.DryRun {
+		// Print the env as configured (keys, and unresolved "ssm:" refs)
+		// rather than resolving it, so a dry run never decrypts and
+		// prints a SecureString value to stdout.
+		fmt.Printf("[dry-run] deploy %s: code=%s sha256=%s env=%v\n", functionName, build.ZipPath, build.Sha256, l.Env)
+		return DeployResult{Feature: name, Action: DeployActionDryRun}, nil
+	}
+
+	env, err := resolveEnv(ctx, params, l.Env, o.LeaveSSMRefs)
+	if err != nil {
+		return result, errors.Wrap(err, "resolveEnv failed")
+	}
+
+	environment := envToAWS(env)
+
+	existing, err := s.API.GetFunction(&lambda.GetFunctionInput{FunctionName: aws.String(functionName)})
+	if err != nil {
+		if !isNotFoundErr(err) {
+			return result, errors.Wrapf(err, "s.API.GetFunction failed (%s)", functionName)
+		}
+
+		if err := s.createFunction(functionName, l, build, environment); err != nil {
+			return result, errors.Wrap(err, "s.createFunction failed")
+		}
+
+		if err := s.ensureEventSourceMapping(l, functionName); err != nil {
+			return result, errors.Wrap(err, "s.ensureEventSourceMapping failed")
+		}
+
+		return DeployResult{Feature: name, Action: DeployActionCreated}, nil
+	}
+
+	// Redeploys can gain a stream/queue trigger even when the code itself
+	// is unchanged, so create the event-source mapping on every pass
+	// through here, not just on first create. A mapping that already
+	// exists is left as it is; changed settings for it are not applied.
+	if err := s.ensureEventSourceMapping(l, functionName); err != nil {
+		return result, errors.Wrap(err, "s.ensureEventSourceMapping failed")
+	}
+
+	if existing.Configuration != nil && aws.StringValue(existing.Configuration.CodeSha256) == build.Sha256 {
+		return DeployResult{Feature: name, Action: DeployActionUnchanged}, nil
+	}
+
+	if err := s.updateFunction(functionName, build, environment); err != nil {
+		return result, errors.Wrap(err, "s.updateFunction failed")
+	}
+
+	return DeployResult{Feature: name, Action: DeployActionUpdated}, nil
+}
+
+// ensureEventSourceMapping calls Service.CreateEventSourceMapping and
+// tolerates the mapping already existing, so it's safe to call on every
+// deploy pass (create and update) rather than only the first create.
+func (s *Service) ensureEventSourceMapping(l Lambda, functionName string) error {
+	_, err := s.CreateEventSourceMapping(l, functionName)
+	if err == nil {
+		return nil
+	}
+
+	if awsErr, ok := errors.Cause(err).(awserr.Error); ok && awsErr.Code() == lambda.ErrCodeResourceConflictException {
+		return nil
+	}
+
+	return err
+}
+
+func (s *Service) createFunction(functionName string, l Lambda, build BuildResult, env *lambda.Environment) error {
+	zipBytes, err := os.ReadFile(build.ZipPath)
+	if err != nil {
+		return errors.Wrapf(err, "os.ReadFile failed (%s)", build.ZipPath)
+	}
+
+	in := &lambda.CreateFunctionInput{
+		FunctionName: aws.String(functionName),
+		Runtime:      aws.String("provided.al2"),
+		Handler:      aws.String(BootstrapEntryName),
+		Role:         aws.String(s.Role),
+		Code:         &lambda.FunctionCode{ZipFile: zipBytes},
+		Environment:  env,
+		Architectures: []*string{
+			aws.String("arm64"),
+		},
+	}
+
+	if _, err := s.API.CreateFunction(in); err != nil {
+		return errors.Wrapf(err, "s.API.CreateFunction failed (%s)", functionName)
+	}
+
+	return nil
+}
+
+func (s *Service) updateFunction(functionName string, build BuildResult, env *lambda.Environment) error {
+	zipBytes, err := os.ReadFile(build.ZipPath)
+	if err != nil {
+		return errors.Wrapf(err, "os.ReadFile failed (%s)", build.ZipPath)
+	}
+
+	codeIn := &lambda.UpdateFunctionCodeInput{
+		FunctionName: aws.String(functionName),
+		ZipFile:      zipBytes,
+	}
+
+	if _, err := s.API.UpdateFunctionCode(codeIn); err != nil {
+		return errors.Wrapf(err, "s.API.UpdateFunctionCode failed (%s)", functionName)
+	}
+
+	configIn := &lambda.UpdateFunctionConfigurationInput{
+		FunctionName: aws.String(functionName),
+		Environment:  env,
+	}
+
+	if _, err := s.API.UpdateFunctionConfiguration(configIn); err != nil {
+		return errors.Wrapf(err, "s.API.UpdateFunctionConfiguration failed (%s)", functionName)
+	}
+
+	return nil
+}
+
+// resolveEnv copies env, replacing any "ssm:/..." value with the value of
+// the referenced parameter, unless leaveRefs is true.
+func resolveEnv(ctx context.Context, params *pstore.Client, env map[string]string, leaveRefs bool) (map[string]string, error) {
+	out := make(map[string]string, len(env))
+	for k, v := range env {
+		if leaveRefs || !strings.HasPrefix(v, SSMEnvPrefix) {
+			out[k] = v
+			continue
+		}
+
+		if params == nil {
+			return nil, errors.Errorf("env %q is an ssm: reference but Deploy was called with a nil pstore.Client", k)
+		}
+
+		key := strings.TrimPrefix(v, SSMEnvPrefix)
+		value, err := params.Param(ctx, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "params.Param failed (%s)", key)
+		}
+
+		out[k] = value
+	}
+
+	return out, nil
+}
+
+func envToAWS(env map[string]string) *lambda.Environment {
+	data := map[string]*string{}
+	for k, v := range env {
+		data[k] = aws.String(v)
+	}
+
+	return &lambda.Environment{Variables: data}
+}
+
+func isNotFoundErr(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+
+	return awsErr.Code() == lambda.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,37 @@
+package sls
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BatchedErrors aggregates the errors collected while Build or Deploy
+// processed multiple features, so one bad Lambda doesn't abort the rest
+// of the service.
+type BatchedErrors struct {
+	errs []error
+}
+
+// NewBatchedErrors wraps errs into a single error. It returns nil if errs
+// is empty.
+func NewBatchedErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &BatchedErrors{errs: errs}
+}
+
+// OrigErrs returns the individual errors that were batched together.
+func (b *BatchedErrors) OrigErrs() []error {
+	return b.errs
+}
+
+func (b *BatchedErrors) Error() string {
+	msgs := make([]string, len(b.errs))
+	for i, e := range b.errs {
+		msgs[i] = e.Error()
+	}
+
+	return fmt.Sprintf("%d error(s) occurred:\n%s", len(b.errs), strings.Join(msgs, "\n"))
+}
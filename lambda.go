@@ -13,15 +13,20 @@ import (
 )
 
 const (
-	DefaultAppDir    = "app"
-	DefaultLambdaDir = "lambdas"
-	APIGWTrigger     = LambdaTrigger("apigw")
-	DDBTrigger       = LambdaTrigger("ddb")
-	DirectTrigger    = LambdaTrigger("direct")
-	CognitoTrigger   = LambdaTrigger("cognito")
-	S3Trigger        = LambdaTrigger("s3")
-	SNSTrigger       = LambdaTrigger("sns")
-	SQSTrigger       = LambdaTrigger("sqs")
+	DefaultAppDir      = "app"
+	DefaultLambdaDir   = "lambdas"
+	APIGWTrigger       = LambdaTrigger("apigw")
+	DDBTrigger         = LambdaTrigger("ddb")
+	DirectTrigger      = LambdaTrigger("direct")
+	CognitoTrigger     = LambdaTrigger("cognito")
+	S3Trigger          = LambdaTrigger("s3")
+	SNSTrigger         = LambdaTrigger("sns")
+	SQSTrigger         = LambdaTrigger("sqs")
+	EventBridgeTrigger = LambdaTrigger("eventbridge")
+	KinesisTrigger     = LambdaTrigger("kinesis")
+	ALBTrigger         = LambdaTrigger("alb")
+	MSKTrigger         = LambdaTrigger("msk")
+	LexTrigger         = LambdaTrigger("lex")
 )
 
 type LambdaTrigger string
@@ -34,29 +39,16 @@ func (lt LambdaTrigger) Empty() bool {
 	return lt.String() == ""
 }
 
+// ToLambdaTrigger resolves s to a registered LambdaTrigger. Built-in
+// triggers are registered by this package's init; RegisterTrigger lets
+// downstream users add their own event-source kinds without forking it.
 func ToLambdaTrigger(s string) (LambdaTrigger, error) {
-	var t LambdaTrigger
-	var err error
-	switch strings.ToLower(s) {
-	case APIGWTrigger.String():
-		t = APIGWTrigger
-	case DDBTrigger.String():
-		t = DDBTrigger
-	case DirectTrigger.String():
-		t = DirectTrigger
-	case CognitoTrigger.String():
-		t = CognitoTrigger
-	case S3Trigger.String():
-		t = S3Trigger
-	case SNSTrigger.String():
-		t = SNSTrigger
-	case SQSTrigger.String():
-		t = SQSTrigger
-	default:
-		err = errors.Errorf("event trigger (%s) is not registered", t)
+	t, ok := triggerRegistry[strings.ToLower(s)]
+	if !ok {
+		return t, errors.Errorf("event trigger (%s) is not registered", s)
 	}
 
-	return t, err
+	return t, nil
 }
 
 type Lambda struct {
@@ -67,6 +59,10 @@ type Lambda struct {
 	BinaryName    string
 	BinaryZipName string
 	Env           map[string]string
+	// Config carries the event-source-specific settings for Trigger
+	// (e.g. KinesisConfig, SQSConfig). It is nil for triggers that need
+	// no extra configuration beyond the trigger kind itself.
+	Config TriggerConfig
 }
 
 func (l Lambda) ToAWSEnv() *lambda.Environment {
@@ -136,6 +132,14 @@ type Service struct {
 	Env      string
 	API      lambdaiface.LambdaAPI
 	Features map[string]Lambda
+	// Role is the IAM execution role ARN used when Deploy creates a
+	// Lambda function for a feature that doesn't already exist.
+	Role string
+
+	// builds holds the result of the most recent Build, keyed by
+	// feature name, so Deploy can compare against each function's
+	// CodeSha256 without rebuilding.
+	builds map[string]BuildResult
 }
 
 func NewService(name, env string, layout ServiceLayout, api lambdaiface.LambdaAPI) *Service {
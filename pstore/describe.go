@@ -0,0 +1,90 @@
+package pstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/rsb/failure"
+)
+
+// ParamMetadata is the subset of SSM parameter metadata Describe returns:
+// its type, tier, policies, version, and last-modified time.
+type ParamMetadata struct {
+	Type             types.ParameterType
+	Tier             types.ParameterTier
+	Version          int64
+	LastModifiedDate time.Time
+	Policies         []ParameterPolicy
+}
+
+// Describe returns key's metadata without reading its value, so callers
+// can detect type/tier drift before overwriting it with Put. If the
+// parameter does not exist a NotFound error is returned.
+func (c *Client) Describe(ctx context.Context, key string) (ParamMetadata, error) {
+	var result ParamMetadata
+	if key == "" {
+		return result, failure.System("key is empty, a non empty key is required")
+	}
+
+	in := ssm.DescribeParametersInput{
+		ParameterFilters: []types.ParameterStringFilter{
+			{
+				Key:    aws.String("Name"),
+				Option: aws.String("Equals"),
+				Values: []string{key},
+			},
+		},
+	}
+
+	out, err := c.api.DescribeParameters(ctx, &in)
+	if err != nil {
+		return result, failure.ToSystem(err, "c.api.DescribeParameters failed (%s)", key)
+	}
+
+	if len(out.Parameters) == 0 {
+		return result, failure.ToNotFound(errors.New("parameter not found"), "parameter (%s) not found", key)
+	}
+
+	p := out.Parameters[0]
+	result.Type = p.Type
+	result.Tier = p.Tier
+	result.Version = p.Version
+	if p.LastModifiedDate != nil {
+		result.LastModifiedDate = *p.LastModifiedDate
+	}
+	result.Policies = decodePolicies(p.Policies)
+
+	return result, nil
+}
+
+// decodePolicies parses the inline policy metadata DescribeParameters
+// returns back into ParameterPolicy values.
+func decodePolicies(in []types.ParameterInlinePolicy) []ParameterPolicy {
+	var out []ParameterPolicy
+	for _, p := range in {
+		var policyType string
+		if p.PolicyType != nil {
+			policyType = *p.PolicyType
+		}
+
+		var attrs map[string]string
+		if p.PolicyText != nil {
+			var parsed struct {
+				Attributes map[string]string `json:"Attributes"`
+			}
+			if err := json.Unmarshal([]byte(*p.PolicyText), &parsed); err == nil {
+				attrs = parsed.Attributes
+			}
+		}
+
+		out = append(out, ParameterPolicy{Type: ParameterPolicyType(policyType), Attributes: attrs})
+	}
+
+	return out
+}
@@ -0,0 +1,38 @@
+package pstore
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BatchedErrors aggregates the errors collected while a multi-page or
+// multi-param operation (Path, PathPages, Collect) partially failed. It
+// still returns whatever data was successfully gathered alongside it,
+// modeled on aws-sdk-go's BatchedErrors.
+type BatchedErrors struct {
+	errs []error
+}
+
+// NewBatchedErrors wraps errs into a single error. It returns nil if errs
+// is empty.
+func NewBatchedErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &BatchedErrors{errs: errs}
+}
+
+// OrigErrs returns the individual errors that were batched together.
+func (b *BatchedErrors) OrigErrs() []error {
+	return b.errs
+}
+
+func (b *BatchedErrors) Error() string {
+	msgs := make([]string, len(b.errs))
+	for i, e := range b.errs {
+		msgs[i] = e.Error()
+	}
+
+	return fmt.Sprintf("%d error(s) occurred:\n%s", len(b.errs), strings.Join(msgs, "\n"))
+}
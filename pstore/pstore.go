@@ -21,6 +21,7 @@ type AdapterAPI interface {
 	GetParametersByPath(ctx context.Context, params *ssm.GetParametersByPathInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error)
 	DeleteParameter(ctx context.Context, params *ssm.DeleteParameterInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error)
 	PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+	DescribeParameters(ctx context.Context, params *ssm.DescribeParametersInput, optFns ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error)
 }
 
 type Client struct {
@@ -42,15 +43,23 @@ func (c *Client) IsEncrypted() bool {
 }
 
 // Param will retrieve a single parameter as `key` returning the value always as a string.
-// If the parameter does not exist a NotFound error is returned
-func (c *Client) Param(ctx context.Context, key string) (string, error) {
+// If the parameter does not exist a NotFound error is returned. By default decryption
+// follows the client-wide IsEncrypted setting; pass withDecryption to override it for
+// this call only.
+func (c *Client) Param(ctx context.Context, key string, withDecryption ...bool) (string, error) {
 	var result string
 	if key == "" {
 		return result, failure.System("key is empty, a non empty key is required")
 	}
+
+	decrypt := c.IsEncrypted()
+	if len(withDecryption) > 0 {
+		decrypt = withDecryption[0]
+	}
+
 	in := ssm.GetParameterInput{
 		Name:           aws.String(key),
-		WithDecryption: c.IsEncrypted(),
+		WithDecryption: decrypt,
 	}
 
 	out, err := c.api.GetParameter(ctx, &in)
@@ -93,6 +102,7 @@ func (c *Client) Path(ctx context.Context, path string, recursive ...bool) (map[
 		out, err := pager.NextPage(ctx)
 		if err != nil {
 			errs = append(errs, failure.Wrap(err, "pager.NextPage failed"))
+			continue
 		}
 		for _, p := range out.Parameters {
 			if p.Name == nil || p.Value == nil {
@@ -102,11 +112,78 @@ func (c *Client) Path(ctx context.Context, path string, recursive ...bool) (map[
 		}
 	}
 
-	return result, nil
+	return result, NewBatchedErrors(errs)
+}
+
+// PathPages retrieves one or more params in a specific hierarchy, invoking
+// fn with each page of results as it is fetched rather than materializing
+// the entire hierarchy in memory. A failure fetching a single page is
+// surfaced to fn directly as its error argument (with a nil page) instead
+// of aborting the traversal, so fn decides whether that page's failure is
+// fatal; fn returning false stops pagination, whether or not err is set.
+// The paginator does not advance past a failed page, so fn returning true
+// after an error requests that same page again; it is not skipped.
+// Every failed attempt is also collected and returned together as a
+// BatchedErrors once pagination ends, so a caller that ignores the err
+// argument still learns about partial failures from PathPages' own
+// return value.
+func (c *Client) PathPages(ctx context.Context, path string, fn func(page map[string]string, err error) bool, recursive ...bool) error {
+	isRecursive := true
+
+	if path == "" {
+		return failure.System("path is empty")
+	}
+
+	path = c.EnsurePathPrefix(path)
+
+	if len(recursive) > 0 && recursive[0] == false {
+		isRecursive = false
+	}
+
+	in := ssm.GetParametersByPathInput{
+		Path:           aws.String(path),
+		WithDecryption: c.IsEncrypted(),
+		Recursive:      isRecursive,
+	}
+
+	pager := ssm.NewGetParametersByPathPaginator(c.api, &in)
+
+	var errs []error
+	for pager.HasMorePages() {
+		out, err := pager.NextPage(ctx)
+		if err != nil {
+			wrapped := failure.Wrap(err, "pager.NextPage failed")
+			errs = append(errs, wrapped)
+			if !fn(nil, wrapped) {
+				break
+			}
+			continue
+		}
+
+		page := map[string]string{}
+		for _, p := range out.Parameters {
+			if p.Name == nil || p.Value == nil {
+				continue
+			}
+			page[*p.Name] = *p.Value
+		}
+
+		if !fn(page, nil) {
+			break
+		}
+	}
+
+	return NewBatchedErrors(errs)
 }
 
 // Collect retrieves one or many params regardless of hierarchy.
-// Note: a second array of strings will report on any invalid params that were sent
+// Note: a second array of strings will report on any invalid params that were sent.
+//
+// Behavior change: previously a non-empty invalid list was only reported via that
+// second return value, with a nil error even though some keys didn't exist. Collect
+// now also returns a non-nil BatchedErrors built from the invalid names, so a caller
+// that only checks `if err != nil` will treat unknown keys as a failure; callers that
+// want to tolerate missing keys should inspect the invalid slice instead of bailing on err.
 func (c *Client) Collect(ctx context.Context, keys ...string) (map[string]string, []string, error) {
 	if len(keys) == 0 {
 		return nil, nil, failure.System("keys must have at least one key")
@@ -145,15 +222,21 @@ func (c *Client) Collect(ctx context.Context, keys ...string) (map[string]string
 		invalid = append(invalid, i)
 	}
 
-	return result, invalid, nil
+	var errs []error
+	for _, i := range invalid {
+		errs = append(errs, failure.System("parameter (%s) is invalid", i))
+	}
+
+	return result, invalid, NewBatchedErrors(errs)
 }
 
 // Delete will remove a single param from the store and return its old value.
-// If the parameter does not exist a NotFound error is returned
-func (c *Client) Delete(ctx context.Context, key string) (string, error) {
+// If the parameter does not exist a NotFound error is returned. withDecryption
+// overrides the client-wide IsEncrypted setting for the read of the old value.
+func (c *Client) Delete(ctx context.Context, key string, withDecryption ...bool) (string, error) {
 	var result string
 
-	result, err := c.Param(ctx, key)
+	result, err := c.Param(ctx, key, withDecryption...)
 	if err != nil {
 		return result, failure.Wrap(err, "c.Param failed (%s)", key)
 	}
@@ -170,8 +253,20 @@ func (c *Client) Delete(ctx context.Context, key string) (string, error) {
 }
 
 // Put will check the existence of the parameter and only change them if they are
-// different, or it does not exist
-func (c *Client) Put(ctx context.Context, key, value string, overwrite ...bool) (string, error) {
+// different, or it does not exist. opts customizes the write (type, tier, KMS key,
+// tags, policies, ...); the zero value writes a Standard-tier ParameterTypeString,
+// matching Put's previous behavior.
+//
+// Breaking change: the trailing `overwrite ...bool` parameter was replaced by
+// `opts ...PutOptions` (with Overwrite as a field). Existing callers passing a bool,
+// e.g. Put(ctx, key, value, true), no longer compile and must switch to
+// Put(ctx, key, value, PutOptions{Overwrite: true}).
+func (c *Client) Put(ctx context.Context, key, value string, opts ...PutOptions) (string, error) {
+	var o PutOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
 	old, err := c.Param(ctx, key)
 	if err != nil && !failure.IsNotFound(err) {
 		return old, failure.Wrap(err, "c.Param failed")
@@ -182,21 +277,51 @@ func (c *Client) Put(ctx context.Context, key, value string, overwrite ...bool)
 		return old, nil
 	}
 
-	var isOverwrite bool
-	if len(overwrite) > 0 && overwrite[0] == true {
-		isOverwrite = true
+	if o.Overwrite == false && old != "" {
+		return old, failure.System("param (%s) exists but overwrite is false", key)
 	}
 
-	if isOverwrite == false && old != "" {
-		return old, failure.System("param (%s) exists but overwrite is false", key)
+	paramType := o.Type
+	if paramType == "" {
+		paramType = types.ParameterTypeString
+	}
+
+	tier := o.Tier
+	if tier == "" {
+		tier = types.ParameterTierStandard
+	}
+
+	policies, err := encodePolicies(o.Policies)
+	if err != nil {
+		return old, failure.Wrap(err, "encodePolicies failed")
 	}
 
 	in := ssm.PutParameterInput{
 		Name:      aws.String(key),
-		Type:      types.ParameterTypeString,
+		Type:      paramType,
 		Value:     aws.String(value),
-		Overwrite: isOverwrite,
-		Tier:      types.ParameterTierStandard,
+		Overwrite: o.Overwrite,
+		Tier:      tier,
+	}
+
+	if paramType == types.ParameterTypeSecureString && o.KeyID != "" {
+		in.KeyId = aws.String(o.KeyID)
+	}
+
+	if o.Description != "" {
+		in.Description = aws.String(o.Description)
+	}
+
+	if o.AllowedPattern != "" {
+		in.AllowedPattern = aws.String(o.AllowedPattern)
+	}
+
+	if policies != "" {
+		in.Policies = aws.String(policies)
+	}
+
+	if len(o.Tags) > 0 {
+		in.Tags = toSSMTags(o.Tags)
 	}
 
 	if _, err := c.api.PutParameter(ctx, &in); err != nil {
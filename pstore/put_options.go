@@ -0,0 +1,85 @@
+package pstore
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/rsb/failure"
+)
+
+// PutOptions customizes how Put writes a parameter: its type, tier, KMS
+// key (for SecureString), description, allowed pattern, tags, and
+// policies.
+type PutOptions struct {
+	Type           types.ParameterType
+	Tier           types.ParameterTier
+	KeyID          string
+	Description    string
+	AllowedPattern string
+	Tags           map[string]string
+	Policies       []ParameterPolicy
+	Overwrite      bool
+}
+
+// ParameterPolicyType enumerates the parameter policy kinds SSM supports.
+type ParameterPolicyType string
+
+const (
+	ExpirationPolicy             = ParameterPolicyType("Expiration")
+	ExpirationNotificationPolicy = ParameterPolicyType("ExpirationNotification")
+	NoChangeNotificationPolicy   = ParameterPolicyType("NoChangeNotification")
+)
+
+// ParameterPolicy is one entry of the "Policies" JSON array SSM attaches
+// to a parameter. Version defaults to "1.0" when empty.
+type ParameterPolicy struct {
+	Type       ParameterPolicyType
+	Version    string
+	Attributes map[string]string
+}
+
+// ssmPolicy is the wire shape of a single SSM parameter policy.
+type ssmPolicy struct {
+	Type       string            `json:"Type"`
+	Version    string            `json:"Version"`
+	Attributes map[string]string `json:"Attributes"`
+}
+
+// encodePolicies serializes policies into the JSON string SSM's
+// PutParameterInput.Policies field expects. It returns "" for no
+// policies.
+func encodePolicies(policies []ParameterPolicy) (string, error) {
+	if len(policies) == 0 {
+		return "", nil
+	}
+
+	out := make([]ssmPolicy, len(policies))
+	for i, p := range policies {
+		version := p.Version
+		if version == "" {
+			version = "1.0"
+		}
+
+		out[i] = ssmPolicy{Type: string(p.Type), Version: version, Attributes: p.Attributes}
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", failure.ToSystem(err, "json.Marshal failed for parameter policies")
+	}
+
+	return string(b), nil
+}
+
+// toSSMTags converts a plain key/value map into the Tag slice
+// PutParameterInput expects.
+func toSSMTags(tags map[string]string) []types.Tag {
+	out := make([]types.Tag, 0, len(tags))
+	for k, v := range tags {
+		key, value := k, v
+		out = append(out, types.Tag{Key: &key, Value: &value})
+	}
+
+	return out
+}
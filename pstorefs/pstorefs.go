@@ -0,0 +1,314 @@
+// Package pstorefs adapts a pstore.Client into a read-only io/fs.FS,
+// modeling an SSM parameter hierarchy as directories and files split on
+// "/". This lets config loaders and stdlib packages that accept an
+// fs.FS (text/template.ParseFS, io/fs.WalkDir, ...) pull configuration
+// out of SSM without writing direct SDK calls.
+package pstorefs
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rsb/failure"
+	"github.com/rsb/sls/pstore"
+)
+
+var (
+	_ fs.FS        = (*FS)(nil)
+	_ fs.ReadDirFS = (*FS)(nil)
+	_ fs.StatFS    = (*FS)(nil)
+	_ fs.SubFS     = (*FS)(nil)
+)
+
+// FS adapts pstore.Client into a filesystem rooted at prefix. SSM
+// parameters have no notion of directories, so FS derives them from the
+// "/" separated parameter names under prefix: a segment with parameters
+// nested beneath it is a directory, a segment with a value and nothing
+// nested beneath it is a file.
+type FS struct {
+	ctx    context.Context
+	client *pstore.Client
+	prefix string
+}
+
+// New returns an FS backed by client, rooted at prefix. ctx is used for
+// every SSM call made while walking the filesystem.
+func New(ctx context.Context, client *pstore.Client, prefix string) (*FS, error) {
+	if client == nil {
+		return nil, failure.System("client is nil, an initialized pstore.Client is required")
+	}
+
+	return &FS{
+		ctx:    ctx,
+		client: client,
+		prefix: client.EnsurePathPrefix(prefix),
+	}, nil
+}
+
+// Sub rebases the filesystem into a nested prefix, reusing the same
+// client and context rather than requiring a new pstore.Client.
+func (f *FS) Sub(dir string) (fs.FS, error) {
+	if dir == "." {
+		return f, nil
+	}
+
+	if !fs.ValidPath(dir) {
+		return nil, &fs.PathError{Op: "sub", Path: dir, Err: fs.ErrInvalid}
+	}
+
+	return &FS{ctx: f.ctx, client: f.client, prefix: path.Join(f.prefix, dir)}, nil
+}
+
+// Open resolves name to a parameter (file) or an intermediate path
+// segment (directory), listing every parameter beneath it with one
+// recursive pstore.Client.Path call (paginated as needed).
+func (f *FS) Open(name string) (fs.File, error) {
+	n, err := f.lookup("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.dir {
+		return newDirFile(name, n), nil
+	}
+
+	return newParamFile(name, n), nil
+}
+
+// Stat returns file info for name without reading a parameter's value.
+func (f *FS) Stat(name string) (fs.FileInfo, error) {
+	n, err := f.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.info(path.Base(name)), nil
+}
+
+// ReadDir lists the entries directly beneath name.
+func (f *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	n, err := f.lookup("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !n.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	var entries []fs.DirEntry
+	for _, child := range n.sortedChildren() {
+		entries = append(entries, dirEntry{name: child.name, node: child.node})
+	}
+
+	return entries, nil
+}
+
+// lookup validates name, lists the parameters nested beneath it with a
+// single call, and resolves the node it refers to. The listing is not
+// cached beyond the call, so each Open/Stat/ReadDir reflects the current
+// state of the parameter store.
+func (f *FS) lookup(op, name string) (*node, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	dirPath := f.prefix
+	if name != "." {
+		dirPath = path.Join(f.prefix, name)
+	}
+
+	// SSM's non-recursive GetParametersByPath only returns leaf
+	// parameters exactly one level below dirPath; it never reports
+	// intermediate path segments, so a non-recursive listing can't
+	// discover nested directories (e.g. dirPath/a/b would be invisible
+	// from dirPath). List recursively instead and derive every
+	// directory in the subtree from the full set of names returned;
+	// the cost is a per-directory re-fetch of its subtree.
+	params, err := f.client.Path(f.ctx, dirPath)
+	if err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+
+	if len(params) == 0 {
+		value, err := f.client.Param(f.ctx, dirPath)
+		if err != nil {
+			if failure.IsNotFound(err) {
+				return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+			}
+			return nil, &fs.PathError{Op: op, Path: name, Err: err}
+		}
+
+		return &node{value: value}, nil
+	}
+
+	return buildTree(dirPath, params), nil
+}
+
+// node is a directory or a parameter value resolved from a listing.
+type node struct {
+	dir      bool
+	value    string
+	children map[string]*node
+}
+
+func (n *node) info(name string) fs.FileInfo {
+	mode := fs.FileMode(0o444)
+	if n.dir {
+		mode = fs.ModeDir | 0o555
+	}
+
+	return fileInfo{name: name, size: int64(len(n.value)), mode: mode}
+}
+
+type namedNode struct {
+	name string
+	node *node
+}
+
+func (n *node) sortedChildren() []namedNode {
+	var out []namedNode
+	for name, child := range n.children {
+		out = append(out, namedNode{name: name, node: child})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].name < out[j].name })
+
+	return out
+}
+
+// buildTree turns a flat map of SSM parameter name -> value, all nested
+// beneath dirPath, into a tree of directory/file nodes.
+func buildTree(dirPath string, params map[string]string) *node {
+	root := &node{dir: true, children: map[string]*node{}}
+
+	for name, value := range params {
+		rel := strings.Trim(strings.TrimPrefix(name, dirPath), "/")
+		if rel == "" {
+			continue
+		}
+
+		segments := strings.Split(rel, "/")
+		cur := root
+		for i, seg := range segments {
+			child, ok := cur.children[seg]
+			if !ok {
+				child = &node{dir: true, children: map[string]*node{}}
+				cur.children[seg] = child
+			}
+
+			if i == len(segments)-1 {
+				child.dir = false
+				child.value = value
+			}
+
+			cur = child
+		}
+	}
+
+	return root
+}
+
+type fileInfo struct {
+	name string
+	size int64
+	mode fs.FileMode
+}
+
+func (i fileInfo) Name() string       { return i.name }
+func (i fileInfo) Size() int64        { return i.size }
+func (i fileInfo) Mode() fs.FileMode  { return i.mode }
+func (i fileInfo) ModTime() time.Time { return time.Time{} }
+func (i fileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i fileInfo) Sys() interface{}   { return nil }
+
+type dirEntry struct {
+	name string
+	node *node
+}
+
+func (e dirEntry) Name() string               { return e.name }
+func (e dirEntry) IsDir() bool                { return e.node.dir }
+func (e dirEntry) Type() fs.FileMode          { return e.node.info(e.name).Mode().Type() }
+func (e dirEntry) Info() (fs.FileInfo, error) { return e.node.info(e.name), nil }
+
+// paramFile is a leaf parameter opened for reading.
+type paramFile struct {
+	name   string
+	info   fs.FileInfo
+	data   []byte
+	offset int
+}
+
+func newParamFile(name string, n *node) *paramFile {
+	return &paramFile{name: name, info: n.info(path.Base(name)), data: []byte(n.value)}
+}
+
+func (f *paramFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+func (f *paramFile) Read(b []byte) (int, error) {
+	if f.offset >= len(f.data) {
+		return 0, io.EOF
+	}
+
+	n := copy(b, f.data[f.offset:])
+	f.offset += n
+
+	return n, nil
+}
+
+func (f *paramFile) Close() error { return nil }
+
+// dirFile is an intermediate path segment opened for reading its
+// children.
+type dirFile struct {
+	name    string
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func newDirFile(name string, n *node) *dirFile {
+	var entries []fs.DirEntry
+	for _, child := range n.sortedChildren() {
+		entries = append(entries, dirEntry{name: child.name, node: child.node})
+	}
+
+	return &dirFile{name: name, info: n.info(path.Base(name)), entries: entries}
+}
+
+func (f *dirFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+
+func (f *dirFile) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrInvalid}
+}
+
+func (f *dirFile) Close() error { return nil }
+
+func (f *dirFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 {
+		entries := f.entries[f.offset:]
+		f.offset = len(f.entries)
+
+		return entries, nil
+	}
+
+	if f.offset >= len(f.entries) {
+		return nil, io.EOF
+	}
+
+	end := f.offset + n
+	if end > len(f.entries) {
+		end = len(f.entries)
+	}
+
+	entries := f.entries[f.offset:end]
+	f.offset = end
+
+	return entries, nil
+}
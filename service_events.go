@@ -0,0 +1,86 @@
+package sls
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+)
+
+// eventSourceARN returns the ARN an event-source mapping should be
+// created against for cfg, or "" if the trigger it belongs to isn't
+// backed by lambdaiface.LambdaAPI.CreateEventSourceMapping (apigw, s3,
+// sns, eventbridge, alb, lex, cognito, direct).
+func eventSourceARN(cfg TriggerConfig) string {
+	switch c := cfg.(type) {
+	case DDBConfig:
+		return c.StreamARN
+	case KinesisConfig:
+		return c.StreamARN
+	case MSKConfig:
+		return c.ClusterARN
+	case SQSConfig:
+		return c.QueueARN
+	default:
+		return ""
+	}
+}
+
+// CreateEventSourceMapping wires l's stream/queue-based trigger (ddb,
+// sqs, kinesis, msk) to functionName via
+// lambdaiface.LambdaAPI.CreateEventSourceMapping, using l.Config for the
+// trigger-specific settings. Triggers that aren't backed by an
+// event-source mapping return (nil, nil) so callers can drive every
+// Lambda through the same call during deploy.
+func (s *Service) CreateEventSourceMapping(l Lambda, functionName string) (*lambda.EventSourceMappingConfiguration, error) {
+	arn := eventSourceARN(l.Config)
+	if arn == "" {
+		return nil, nil
+	}
+
+	in := &lambda.CreateEventSourceMappingInput{
+		EventSourceArn: aws.String(arn),
+		FunctionName:   aws.String(functionName),
+		Enabled:        aws.Bool(true),
+	}
+
+	switch c := l.Config.(type) {
+	case DDBConfig:
+		if c.BatchSize > 0 {
+			in.BatchSize = aws.Int64(c.BatchSize)
+		}
+		if c.StartingPosition != "" {
+			in.StartingPosition = aws.String(c.StartingPosition)
+		}
+	case KinesisConfig:
+		if c.BatchSize > 0 {
+			in.BatchSize = aws.Int64(c.BatchSize)
+		}
+		if c.StartingPosition != "" {
+			in.StartingPosition = aws.String(c.StartingPosition)
+		}
+	case MSKConfig:
+		if c.Topic != "" {
+			in.Topics = aws.StringSlice([]string{c.Topic})
+		}
+		if c.BatchSize > 0 {
+			in.BatchSize = aws.Int64(c.BatchSize)
+		}
+	case SQSConfig:
+		if c.BatchSize > 0 {
+			in.BatchSize = aws.Int64(c.BatchSize)
+		}
+		if c.MaximumBatchingWindowSeconds > 0 {
+			in.MaximumBatchingWindowInSeconds = aws.Int64(c.MaximumBatchingWindowSeconds)
+		}
+		if len(c.FunctionResponseTypes) > 0 {
+			in.FunctionResponseTypes = aws.StringSlice(c.FunctionResponseTypes)
+		}
+	}
+
+	out, err := s.API.CreateEventSourceMapping(in)
+	if err != nil {
+		return nil, errors.Wrapf(err, "s.API.CreateEventSourceMapping failed (%s)", l.QualifiedName())
+	}
+
+	return out, nil
+}
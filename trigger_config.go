@@ -0,0 +1,73 @@
+package sls
+
+// TriggerConfig carries the event-source-specific settings a Lambda's
+// Trigger needs. During deploy Service creates an event-source mapping
+// from a DDBConfig, KinesisConfig, MSKConfig or SQSConfig; it does not
+// update an existing mapping, and the other configs are not acted on by
+// Service.
+type TriggerConfig interface {
+	Trigger() LambdaTrigger
+}
+
+// EventBridgeConfig configures a Lambda invoked by an EventBridge rule.
+// Exactly one of EventPattern or ScheduleExpression is normally set.
+type EventBridgeConfig struct {
+	RuleName           string
+	EventPattern       string
+	ScheduleExpression string
+}
+
+func (EventBridgeConfig) Trigger() LambdaTrigger { return EventBridgeTrigger }
+
+// KinesisConfig configures a Lambda triggered by a Kinesis stream.
+type KinesisConfig struct {
+	StreamARN        string
+	BatchSize        int64
+	StartingPosition string
+}
+
+func (KinesisConfig) Trigger() LambdaTrigger { return KinesisTrigger }
+
+// DDBConfig configures a Lambda triggered by a DynamoDB Streams stream.
+type DDBConfig struct {
+	StreamARN        string
+	BatchSize        int64
+	StartingPosition string
+}
+
+func (DDBConfig) Trigger() LambdaTrigger { return DDBTrigger }
+
+// ALBConfig configures a Lambda invoked as an ALB target group target.
+type ALBConfig struct {
+	TargetGroupARN string
+}
+
+func (ALBConfig) Trigger() LambdaTrigger { return ALBTrigger }
+
+// MSKConfig configures a Lambda triggered by a topic on an MSK cluster.
+type MSKConfig struct {
+	ClusterARN string
+	Topic      string
+	BatchSize  int64
+}
+
+func (MSKConfig) Trigger() LambdaTrigger { return MSKTrigger }
+
+// LexConfig configures a Lambda used as a Lex bot's fulfillment or
+// validation hook.
+type LexConfig struct {
+	BotName  string
+	BotAlias string
+}
+
+func (LexConfig) Trigger() LambdaTrigger { return LexTrigger }
+
+// SQSConfig configures a Lambda triggered by an SQS queue.
+type SQSConfig struct {
+	QueueARN                     string
+	BatchSize                    int64
+	MaximumBatchingWindowSeconds int64
+	FunctionResponseTypes        []string
+}
+
+func (SQSConfig) Trigger() LambdaTrigger { return SQSTrigger }
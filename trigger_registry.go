@@ -0,0 +1,52 @@
+package sls
+
+import (
+	"sort"
+	"strings"
+)
+
+// triggerRegistry holds every LambdaTrigger kind this package (or a
+// downstream user) knows how to handle.
+var triggerRegistry = map[string]LambdaTrigger{}
+
+func init() {
+	RegisterTrigger(APIGWTrigger.String())
+	RegisterTrigger(DDBTrigger.String())
+	RegisterTrigger(DirectTrigger.String())
+	RegisterTrigger(CognitoTrigger.String())
+	RegisterTrigger(S3Trigger.String())
+	RegisterTrigger(SNSTrigger.String())
+	RegisterTrigger(SQSTrigger.String())
+	RegisterTrigger(EventBridgeTrigger.String())
+	RegisterTrigger(KinesisTrigger.String())
+	RegisterTrigger(ALBTrigger.String())
+	RegisterTrigger(MSKTrigger.String())
+	RegisterTrigger(LexTrigger.String())
+}
+
+// RegisterTrigger adds name to the set of recognized LambdaTrigger kinds
+// and returns the LambdaTrigger for it, so downstream users can support
+// their own event-source kinds without forking this package.
+func RegisterTrigger(name string) LambdaTrigger {
+	t := LambdaTrigger(name)
+	// ToLambdaTrigger lowercases its input, so key by the lowercased name.
+	triggerRegistry[strings.ToLower(t.String())] = t
+
+	return t
+}
+
+// Triggers returns every registered LambdaTrigger, sorted by name.
+func Triggers() []LambdaTrigger {
+	names := make([]string, 0, len(triggerRegistry))
+	for name := range triggerRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]LambdaTrigger, 0, len(names))
+	for _, name := range names {
+		out = append(out, triggerRegistry[name])
+	}
+
+	return out
+}